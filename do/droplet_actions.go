@@ -0,0 +1,212 @@
+package do
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/util"
+)
+
+// DropletActionsService is an interface for interacting with DigitalOcean's droplet actions api.
+type DropletActionsService interface {
+	Shutdown(context.Context, int, bool) (*Action, error)
+	ShutdownByTag(context.Context, string, bool) (Actions, error)
+	PowerOff(context.Context, int, bool) (*Action, error)
+	PowerOffByTag(context.Context, string, bool) (Actions, error)
+	PowerOn(context.Context, int, bool) (*Action, error)
+	PowerOnByTag(context.Context, string, bool) (Actions, error)
+	PowerCycle(context.Context, int, bool) (*Action, error)
+	PowerCycleByTag(context.Context, string, bool) (Actions, error)
+	Reboot(context.Context, int, bool) (*Action, error)
+	Rename(context.Context, int, string, bool) (*Action, error)
+	Resize(context.Context, int, string, bool, bool) (*Action, error)
+	Rebuild(context.Context, int, string, bool) (*Action, error)
+	Restore(context.Context, int, int, bool) (*Action, error)
+	Snapshot(context.Context, int, string, bool) (*Action, error)
+	SnapshotByTag(context.Context, string, string, bool) (Actions, error)
+	EnableBackups(context.Context, int, bool) (*Action, error)
+	EnableBackupsByTag(context.Context, string, bool) (Actions, error)
+	DisableBackups(context.Context, int, bool) (*Action, error)
+	DisableBackupsByTag(context.Context, string, bool) (Actions, error)
+	PasswordReset(context.Context, int, bool) (*Action, error)
+	EnableIPv6(context.Context, int, bool) (*Action, error)
+	EnablePrivateNetworking(context.Context, int, bool) (*Action, error)
+	ChangeKernel(context.Context, int, int, bool) (*Action, error)
+	Upgrade(context.Context, int, bool) (*Action, error)
+}
+
+type dropletActionsService struct {
+	client *godo.Client
+}
+
+var _ DropletActionsService = &dropletActionsService{}
+
+// NewDropletActionsService builds a DropletActionsService instance.
+func NewDropletActionsService(client *godo.Client) DropletActionsService {
+	return &dropletActionsService{
+		client: client,
+	}
+}
+
+// waitForAction polls a single action to completion when wait is true. Unlike
+// DropletsService.Create, action responses don't carry a Links.Actions HREF
+// to poll, so the monitor URI is built from the action's own ID and the
+// Droplet it was taken against (Action.ResourceID).
+func (das *dropletActionsService) waitForAction(ctx context.Context, a *godo.Action, wait bool) error {
+	if !wait {
+		return nil
+	}
+
+	uri := fmt.Sprintf("%sv2/droplets/%d/actions/%d", das.client.BaseURL, a.ResourceID, a.ID)
+	return util.WaitForActive(ctx, das.client, uri)
+}
+
+func (das *dropletActionsService) handleActionResponse(ctx context.Context, a *godo.Action, wait bool, err error) (*Action, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	if err := das.waitForAction(ctx, a, wait); err != nil {
+		return nil, err
+	}
+
+	return &Action{Action: a}, nil
+}
+
+func (das *dropletActionsService) handleTagActionResponse(ctx context.Context, a []godo.Action, wait bool, err error) (Actions, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make(Actions, 0, len(a))
+	for i := range a {
+		if err := das.waitForAction(ctx, &a[i], wait); err != nil {
+			return nil, err
+		}
+
+		actions = append(actions, Action{Action: &a[i]})
+	}
+
+	return actions, nil
+}
+
+func (das *dropletActionsService) Shutdown(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Shutdown(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) ShutdownByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.ShutdownByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerOff(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerOff(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerOffByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerOffByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerOn(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerOn(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerOnByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerOnByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerCycle(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.PowerCycle(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PowerCycleByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.PowerCycleByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Reboot(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Reboot(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Rename(ctx context.Context, id int, name string, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Rename(ctx, id, name)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Resize(ctx context.Context, id int, sizeSlug string, resizeDisk bool, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Resize(ctx, id, sizeSlug, resizeDisk)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Rebuild(ctx context.Context, id int, image string, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.RebuildByImageSlug(ctx, id, image)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Restore(ctx context.Context, id, imageID int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Restore(ctx, id, imageID)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Snapshot(ctx context.Context, id int, name string, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Snapshot(ctx, id, name)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) SnapshotByTag(ctx context.Context, tag, name string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.SnapshotByTag(ctx, tag, name)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) EnableBackups(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnableBackups(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) EnableBackupsByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.EnableBackupsByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) DisableBackups(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.DisableBackups(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) DisableBackupsByTag(ctx context.Context, tag string, wait bool) (Actions, error) {
+	a, _, err := das.client.DropletActions.DisableBackupsByTag(ctx, tag)
+	return das.handleTagActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) PasswordReset(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.PasswordReset(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) EnableIPv6(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnableIPv6(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) EnablePrivateNetworking(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.EnablePrivateNetworking(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) ChangeKernel(ctx context.Context, id, kernelID int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.ChangeKernel(ctx, id, kernelID)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
+
+func (das *dropletActionsService) Upgrade(ctx context.Context, id int, wait bool) (*Action, error) {
+	a, _, err := das.client.DropletActions.Upgrade(ctx, id)
+	return das.handleActionResponse(ctx, a, wait, err)
+}
@@ -0,0 +1,85 @@
+package do
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDropletActionsService(t *testing.T, mux *http.ServeMux) (DropletActionsService, *httptest.Server) {
+	server := httptest.NewServer(mux)
+	client := godo.NewClient(nil)
+
+	// Keep the trailing slash of godo's default BaseURL: waitForAction appends
+	// "v2/..." to it directly when building the action monitor URI.
+	u, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	client.BaseURL = u
+
+	return NewDropletActionsService(client), server
+}
+
+func TestDropletActionsService_ShutdownByTag_DoesNotAliasActions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/droplets/actions", func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Actions []godo.Action `json:"actions"`
+		}{
+			Actions: []godo.Action{
+				{ID: 1, Status: "in-progress"},
+				{ID: 2, Status: "in-progress"},
+				{ID: 3, Status: "in-progress"},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	das, server := newTestDropletActionsService(t, mux)
+	defer server.Close()
+
+	actions, err := das.ShutdownByTag(context.Background(), "web", false)
+	require.NoError(t, err)
+	require.Len(t, actions, 3)
+
+	ids := make([]int, len(actions))
+	for i, a := range actions {
+		ids[i] = a.ID
+	}
+
+	// Each returned Action must keep its own ID; a loop-variable aliasing bug
+	// would collapse every entry down to the ID of the last one iterated.
+	assert.Equal(t, []int{1, 2, 3}, ids)
+}
+
+func TestDropletActionsService_Shutdown_WaitPropagatesActionError(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/droplets/1/actions", func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Action godo.Action `json:"action"`
+		}{
+			Action: godo.Action{ID: 1, Status: "in-progress", ResourceID: 1},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	// waitForAction polls this path (built from the action's own ResourceID
+	// and ID, since action responses carry no Links.Actions HREF to follow).
+	mux.HandleFunc("/v2/droplets/1/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Action godo.Action `json:"action"`
+		}{Action: godo.Action{ID: 1, Status: "errored", ResourceID: 1}}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	das, server := newTestDropletActionsService(t, mux)
+	defer server.Close()
+
+	_, err := das.Shutdown(context.Background(), 1, true)
+	assert.Error(t, err, "an errored action should surface as an error when --wait is set, not be swallowed")
+}
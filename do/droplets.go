@@ -1,6 +1,10 @@
 package do
 
 import (
+	"context"
+	"path"
+	"strconv"
+
 	"github.com/digitalocean/godo"
 	"github.com/digitalocean/godo/util"
 )
@@ -23,6 +27,11 @@ type Droplet struct {
 	*godo.Droplet
 }
 
+// Tags returns the tags applied to the Droplet.
+func (d *Droplet) Tags() []string {
+	return d.Droplet.Tags
+}
+
 // IPs returns a map of interface.s
 func (d *Droplet) IPs() DropletIPTable {
 	t := DropletIPTable{}
@@ -51,16 +60,22 @@ type Kernels []Kernel
 
 // DropletsService is an interface for interacting with DigitalOcean's droplet api.
 type DropletsService interface {
-	List() (Droplets, error)
-	Get(int) (*Droplet, error)
-	Create(*godo.DropletCreateRequest, bool) (*Droplet, error)
-	CreateMultiple(*godo.DropletMultiCreateRequest) (Droplets, error)
-	Delete(int) error
-	Kernels(int) (Kernels, error)
-	Snapshots(int) (Images, error)
-	Backups(int) (Images, error)
-	Actions(int) (Actions, error)
-	Neighbors(int) (Droplets, error)
+	List(context.Context) (Droplets, error)
+	ListByTag(context.Context, string) (Droplets, error)
+	ListByName(context.Context, string) (Droplets, error)
+	ListByNameGlob(context.Context, string) (Droplets, error)
+	Get(context.Context, int) (*Droplet, error)
+	Create(context.Context, *godo.DropletCreateRequest, bool) (*Droplet, error)
+	CreateMultiple(context.Context, *godo.DropletMultiCreateRequest) (Droplets, error)
+	Delete(context.Context, int) error
+	DeleteByTag(context.Context, string) error
+	Kernels(context.Context, int) (Kernels, error)
+	Snapshots(context.Context, int) (Images, error)
+	Backups(context.Context, int) (Images, error)
+	Actions(context.Context, int) (Actions, error)
+	Neighbors(context.Context, int) (Droplets, error)
+	Tag(context.Context, int, string) error
+	Untag(context.Context, int, string) error
 }
 
 type dropletsService struct {
@@ -69,16 +84,39 @@ type dropletsService struct {
 
 var _ DropletsService = &dropletsService{}
 
-// NewDropletsService builds a DropletsService instance.
+// NewDropletsService builds a DropletsService instance. It intentionally does
+// not take a context.Context: per-request contexts are threaded through each
+// method call instead, since storing a context on a long-lived struct is an
+// anti-pattern (https://golang.org/pkg/context/#Context) and would tie every
+// call made through this service to whatever context happened to be current
+// when it was constructed.
 func NewDropletsService(client *godo.Client) DropletsService {
 	return &dropletsService{
 		client: client,
 	}
 }
 
-func (ds *dropletsService) List() (Droplets, error) {
+func (ds *dropletsService) List(ctx context.Context) (Droplets, error) {
+	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
+		list, resp, err := ds.client.Droplets.List(ctx, opt)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		si := make([]interface{}, len(list))
+		for i := range list {
+			si[i] = list[i]
+		}
+
+		return si, resp, err
+	}
+
+	return paginateDroplets(ctx, f)
+}
+
+func (ds *dropletsService) ListByTag(ctx context.Context, tag string) (Droplets, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.List(opt)
+		list, resp, err := ds.client.Droplets.ListByTag(ctx, tag, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -91,7 +129,48 @@ func (ds *dropletsService) List() (Droplets, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	return paginateDroplets(ctx, f)
+}
+
+func (ds *dropletsService) ListByName(ctx context.Context, name string) (Droplets, error) {
+	list, err := ds.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched Droplets
+	for _, d := range list {
+		if d.Name == name {
+			matched = append(matched, d)
+		}
+	}
+
+	return matched, nil
+}
+
+func (ds *dropletsService) ListByNameGlob(ctx context.Context, glob string) (Droplets, error) {
+	list, err := ds.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched Droplets
+	for _, d := range list {
+		ok, err := path.Match(glob, d.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			matched = append(matched, d)
+		}
+	}
+
+	return matched, nil
+}
+
+func paginateDroplets(ctx context.Context, f func(*godo.ListOptions) ([]interface{}, *godo.Response, error)) (Droplets, error) {
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +184,8 @@ func (ds *dropletsService) List() (Droplets, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Get(id int) (*Droplet, error) {
-	d, _, err := ds.client.Droplets.Get(id)
+func (ds *dropletsService) Get(ctx context.Context, id int) (*Droplet, error) {
+	d, _, err := ds.client.Droplets.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +193,21 @@ func (ds *dropletsService) Get(id int) (*Droplet, error) {
 	return &Droplet{Droplet: d}, nil
 }
 
-func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*Droplet, error) {
-	d, resp, err := ds.client.Droplets.Create(dcr)
+func (ds *dropletsService) Create(ctx context.Context, dcr *godo.DropletCreateRequest, wait bool) (*Droplet, error) {
+	d, resp, err := ds.client.Droplets.Create(ctx, dcr)
 	if err != nil {
 		return nil, err
 	}
 
+	// The Droplet already exists at this point, so tags can be applied
+	// immediately; this must not be gated on wait, since tagging doesn't
+	// need the Droplet to be active, only to exist.
+	if len(dcr.Tags) > 0 {
+		if err := ds.tagAll(ctx, d.ID, dcr.Tags); err != nil {
+			return nil, err
+		}
+	}
+
 	if wait {
 		var action *godo.LinkAction
 		for _, a := range resp.Links.Actions {
@@ -130,8 +218,11 @@ func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*D
 		}
 
 		if action != nil {
-			_ = util.WaitForActive(ds.client, action.HREF)
-			doDroplet, err := ds.Get(d.ID)
+			_ = util.WaitForActive(ctx, ds.client, action.HREF)
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			doDroplet, err := ds.Get(ctx, d.ID)
 			if err != nil {
 				return nil, err
 			}
@@ -142,28 +233,81 @@ func (ds *dropletsService) Create(dcr *godo.DropletCreateRequest, wait bool) (*D
 	return &Droplet{Droplet: d}, nil
 }
 
-func (ds *dropletsService) CreateMultiple(dmcr *godo.DropletMultiCreateRequest) (Droplets, error) {
-	godoDroplets, _, err := ds.client.Droplets.CreateMultiple(dmcr)
+// tagAll applies tags to a Droplet once it exists, since the tag resources
+// endpoint requires the Droplet to already be present.
+func (ds *dropletsService) tagAll(ctx context.Context, id int, tags []string) error {
+	for _, tag := range tags {
+		if err := ds.Tag(ctx, id, tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ds *dropletsService) Tag(ctx context.Context, dropletID int, tag string) error {
+	req := &godo.TagResourcesRequest{
+		Resources: []godo.Resource{
+			{
+				ID:   strconv.Itoa(dropletID),
+				Type: godo.DropletResourceType,
+			},
+		},
+	}
+
+	_, err := ds.client.Tags.TagResources(ctx, tag, req)
+	return err
+}
+
+func (ds *dropletsService) Untag(ctx context.Context, dropletID int, tag string) error {
+	req := &godo.UntagResourcesRequest{
+		Resources: []godo.Resource{
+			{
+				ID:   strconv.Itoa(dropletID),
+				Type: godo.DropletResourceType,
+			},
+		},
+	}
+
+	_, err := ds.client.Tags.UntagResources(ctx, tag, req)
+	return err
+}
+
+func (ds *dropletsService) CreateMultiple(ctx context.Context, dmcr *godo.DropletMultiCreateRequest) (Droplets, error) {
+	godoDroplets, _, err := ds.client.Droplets.CreateMultiple(ctx, dmcr)
 	if err != nil {
 		return nil, err
 	}
 
 	var droplets Droplets
 	for _, d := range godoDroplets {
+		// As in Create, each Droplet already exists at this point, so tags
+		// can be applied immediately without waiting for it to become active.
+		if len(dmcr.Tags) > 0 {
+			if err := ds.tagAll(ctx, d.ID, dmcr.Tags); err != nil {
+				return nil, err
+			}
+		}
+
 		droplets = append(droplets, Droplet{Droplet: &d})
 	}
 
 	return droplets, nil
 }
 
-func (ds *dropletsService) Delete(id int) error {
-	_, err := ds.client.Droplets.Delete(id)
+func (ds *dropletsService) Delete(ctx context.Context, id int) error {
+	_, err := ds.client.Droplets.Delete(ctx, id)
+	return err
+}
+
+func (ds *dropletsService) DeleteByTag(ctx context.Context, tag string) error {
+	_, err := ds.client.Droplets.DeleteByTag(ctx, tag)
 	return err
 }
 
-func (ds *dropletsService) Kernels(id int) (Kernels, error) {
+func (ds *dropletsService) Kernels(ctx context.Context, id int) (Kernels, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Kernels(id, opt)
+		list, resp, err := ds.client.Droplets.Kernels(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -176,7 +320,7 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -190,9 +334,9 @@ func (ds *dropletsService) Kernels(id int) (Kernels, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Snapshots(id int) (Images, error) {
+func (ds *dropletsService) Snapshots(ctx context.Context, id int) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Snapshots(id, opt)
+		list, resp, err := ds.client.Droplets.Snapshots(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -205,7 +349,7 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -219,9 +363,9 @@ func (ds *dropletsService) Snapshots(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Backups(id int) (Images, error) {
+func (ds *dropletsService) Backups(ctx context.Context, id int) (Images, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Backups(id, opt)
+		list, resp, err := ds.client.Droplets.Backups(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -234,7 +378,7 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -248,9 +392,9 @@ func (ds *dropletsService) Backups(id int) (Images, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Actions(id int) (Actions, error) {
+func (ds *dropletsService) Actions(ctx context.Context, id int) (Actions, error) {
 	f := func(opt *godo.ListOptions) ([]interface{}, *godo.Response, error) {
-		list, resp, err := ds.client.Droplets.Actions(id, opt)
+		list, resp, err := ds.client.Droplets.Actions(ctx, id, opt)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -263,7 +407,7 @@ func (ds *dropletsService) Actions(id int) (Actions, error) {
 		return si, resp, err
 	}
 
-	si, err := PaginateResp(f)
+	si, err := PaginateResp(ctx, f)
 	if err != nil {
 		return nil, err
 	}
@@ -277,8 +421,8 @@ func (ds *dropletsService) Actions(id int) (Actions, error) {
 	return list, nil
 }
 
-func (ds *dropletsService) Neighbors(id int) (Droplets, error) {
-	list, _, err := ds.client.Droplets.Neighbors(id)
+func (ds *dropletsService) Neighbors(ctx context.Context, id int) (Droplets, error) {
+	list, _, err := ds.client.Droplets.Neighbors(ctx, id)
 	if err != nil {
 		return nil, err
 	}
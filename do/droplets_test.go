@@ -0,0 +1,97 @@
+package do
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDropletsService(t *testing.T, mux *http.ServeMux) (DropletsService, *httptest.Server) {
+	server := httptest.NewServer(mux)
+	client := godo.NewClient(nil)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	client.BaseURL = u
+
+	return NewDropletsService(client), server
+}
+
+func TestDropletsService_Create_TagsWithoutWaiting(t *testing.T) {
+	var tagged []string
+	var actionPolled bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Droplet godo.Droplet `json:"droplet"`
+			Links   struct {
+				Actions []godo.LinkAction `json:"actions"`
+			} `json:"links"`
+		}{
+			Droplet: godo.Droplet{ID: 1, Name: "web-1"},
+		}
+		resp.Links.Actions = []godo.LinkAction{
+			{ID: 1, Rel: "create", HREF: "http://example.com/v2/actions/1"},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	mux.HandleFunc("/v2/tags/web/resources", func(w http.ResponseWriter, r *http.Request) {
+		tagged = append(tagged, "web-1")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/v2/actions/1", func(w http.ResponseWriter, r *http.Request) {
+		actionPolled = true
+		resp := struct {
+			Action godo.Action `json:"action"`
+		}{Action: godo.Action{Status: "completed"}}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	ds, server := newTestDropletsService(t, mux)
+	defer server.Close()
+
+	dcr := &godo.DropletCreateRequest{Name: "web-1", Tags: []string{"web"}}
+	_, err := ds.Create(context.Background(), dcr, false)
+	require.NoError(t, err)
+
+	assert.Len(t, tagged, 1, "tags should be applied even though wait was false")
+	assert.False(t, actionPolled, "Create with wait=false must not block on polling the create action to apply tags")
+}
+
+func TestDropletsService_ListByNameGlob(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/droplets", func(w http.ResponseWriter, r *http.Request) {
+		resp := struct {
+			Droplets []godo.Droplet `json:"droplets"`
+			Links    struct{}       `json:"links"`
+			Meta     struct {
+				Total int `json:"total"`
+			} `json:"meta"`
+		}{
+			Droplets: []godo.Droplet{
+				{ID: 1, Name: "web-1"},
+				{ID: 2, Name: "web-2"},
+				{ID: 3, Name: "db-1"},
+			},
+		}
+		resp.Meta.Total = len(resp.Droplets)
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	ds, server := newTestDropletsService(t, mux)
+	defer server.Close()
+
+	matched, err := ds.ListByNameGlob(context.Background(), "web-*")
+	require.NoError(t, err)
+	require.Len(t, matched, 2)
+	assert.Equal(t, "web-1", matched[0].Name)
+	assert.Equal(t, "web-2", matched[1].Name)
+}